@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	trmgorm "github.com/avito-tech/go-transaction-manager/gorm"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/KozhurkinTimur/dip/internal/handler"
+	"github.com/KozhurkinTimur/dip/internal/metrics"
+	"github.com/KozhurkinTimur/dip/internal/repository"
+	"github.com/KozhurkinTimur/dip/internal/server"
+	"github.com/KozhurkinTimur/dip/internal/service"
+	"github.com/KozhurkinTimur/dip/internal/storage"
+)
+
+const (
+	defaultMaxAttachmentSize = 100 << 20 // 100 MiB
+	defaultShutdownTimeout   = 10 * time.Second
+)
+
+func main() {
+	legacyRoutes := flag.Bool("legacy-routes", true, "also serve the deprecated pre-v1 routes alongside /api/v1")
+	flag.Parse()
+
+	db, err := connectDB()
+	if err != nil {
+		panic("failed to connect database")
+	}
+
+	if err := repository.Migrate(db); err != nil {
+		panic("failed to migrate database")
+	}
+
+	if err := metrics.RegisterGormCallbacks(db); err != nil {
+		panic("failed to register gorm metrics callbacks")
+	}
+
+	store, staticDir, err := storage.NewFromEnv()
+	if err != nil {
+		panic("failed to initialize storage backend")
+	}
+
+	courseRepo := repository.NewCourseRepository(db, trmgorm.DefaultCtxGetter)
+	userRepo := repository.NewUserRepository(db, trmgorm.DefaultCtxGetter)
+	attachmentRepo := repository.NewAttachmentRepository(db, trmgorm.DefaultCtxGetter)
+
+	courseService := service.NewCourseService(courseRepo)
+	userService := service.NewUserService(userRepo)
+	attachmentService := service.NewAttachmentService(attachmentRepo, store, maxAttachmentSize())
+
+	router := handler.NewRouter(userService, courseService, attachmentService, handler.RouterOptions{
+		LegacyRoutes: *legacyRoutes,
+		StaticDir:    staticDir,
+		Ping:         repository.Ping(db),
+	})
+
+	srv := server.New(router, "0.0.0.0:8080")
+	runAndWaitForShutdown(srv, db)
+}
+
+// runAndWaitForShutdown serves until SIGINT/SIGTERM, then drains in-flight
+// requests and closes the DB pool before returning.
+func runAndWaitForShutdown(srv *server.Server, db *gorm.DB) {
+	go func() {
+		if err := srv.Run(); err != nil && err != http.ErrServerClosed {
+			panic(err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout())
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "graceful shutdown failed: %v\n", err)
+	}
+
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
+
+func shutdownTimeout() time.Duration {
+	raw := os.Getenv("SHUTDOWN_TIMEOUT")
+	if raw == "" {
+		return defaultShutdownTimeout
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return defaultShutdownTimeout
+	}
+
+	return d
+}
+
+func maxAttachmentSize() int64 {
+	raw := os.Getenv("ATTACHMENT_MAX_SIZE_BYTES")
+	if raw == "" {
+		return defaultMaxAttachmentSize
+	}
+
+	size, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return defaultMaxAttachmentSize
+	}
+
+	return size
+}
+
+func connectDB() (*gorm.DB, error) {
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_DBNAME"),
+		os.Getenv("DB_SSL"),
+	)
+
+	return gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
+}
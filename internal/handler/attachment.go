@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/service"
+)
+
+type AttachmentHandler struct {
+	attachments service.AttachmentService
+}
+
+func NewAttachmentHandler(attachments service.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{attachments: attachments}
+}
+
+func (h *AttachmentHandler) Upload(c *gin.Context) {
+	courseId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid id", "course id must be a UUID")
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid request", "a \"file\" multipart field is required")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to read upload")
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachments.Upload(c.Request.Context(), courseId, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrFileTooLarge):
+			WriteProblem(c, http.StatusRequestEntityTooLarge, "File Too Large", "attachment exceeds the configured size limit")
+		case errors.Is(err, model.ErrUnsupportedType):
+			WriteProblem(c, http.StatusUnsupportedMediaType, "Unsupported Media Type", "only PDF, image and video attachments are accepted")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to store attachment")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachment)
+}
+
+func (h *AttachmentHandler) List(c *gin.Context) {
+	courseId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid id", "course id must be a UUID")
+		return
+	}
+
+	attachments, err := h.attachments.List(c.Request.Context(), courseId)
+	if err != nil {
+		WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to list attachments")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"items": attachments})
+}
+
+func (h *AttachmentHandler) Delete(c *gin.Context) {
+	attachmentId, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid id", "attachment id must be a UUID")
+		return
+	}
+
+	if err := h.attachments.Delete(c.Request.Context(), attachmentId); err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			WriteProblem(c, http.StatusNotFound, "Not Found", "attachment not found")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to delete attachment")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CORSConfig drives corsMiddleware. Load it from the environment with
+// CORSConfigFromEnv, or build one directly in tests.
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// CORSConfigFromEnv reads CORS_ALLOWED_ORIGINS, CORS_ALLOWED_METHODS,
+// CORS_ALLOWED_HEADERS, CORS_ALLOW_CREDENTIALS and CORS_MAX_AGE.
+// CORS_ALLOWED_ORIGINS entries may contain a single "*" wildcard, e.g.
+// "https://*.example.com", or be exactly "*" to allow any origin.
+func CORSConfigFromEnv() CORSConfig {
+	return CORSConfig{
+		AllowedOrigins:   splitEnvList("CORS_ALLOWED_ORIGINS", []string{"*"}),
+		AllowedMethods:   splitEnvList("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
+		AllowedHeaders:   splitEnvList("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization"}),
+		AllowCredentials: os.Getenv("CORS_ALLOW_CREDENTIALS") == "true",
+		MaxAge:           time.Duration(envInt("CORS_MAX_AGE", 600)) * time.Second,
+	}
+}
+
+func splitEnvList(name string, fallback []string) []string {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback
+	}
+
+	parts := strings.Split(raw, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+func envInt(name string, fallback int) int {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return fallback
+	}
+
+	return v
+}
+
+func (cfg CORSConfig) hasWildcardOrigin() bool {
+	for _, allowed := range cfg.AllowedOrigins {
+		if allowed == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (cfg CORSConfig) isOriginAllowed(origin string) bool {
+	if origin == "" {
+		return false
+	}
+
+	for _, allowed := range cfg.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+		if strings.Contains(allowed, "*") && matchWildcardOrigin(allowed, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchWildcardOrigin supports a single "*" in the pattern, e.g.
+// "https://*.example.com" matching "https://app.example.com".
+func matchWildcardOrigin(pattern, origin string) bool {
+	parts := strings.SplitN(pattern, "*", 2)
+	if len(parts) != 2 {
+		return false
+	}
+
+	prefix, suffix := parts[0], parts[1]
+	return strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix)
+}
+
+// corsMiddleware answers preflight requests and annotates real ones according
+// to cfg, matching the requesting Origin instead of always allowing "*" -
+// which previously broke credentialed requests and accepted every origin.
+func corsMiddleware(cfg CORSConfig) gin.HandlerFunc {
+	allowedMethods := strings.Join(cfg.AllowedMethods, ", ")
+	defaultAllowedHeaders := strings.Join(cfg.AllowedHeaders, ", ")
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		c.Writer.Header().Add("Vary", "Origin")
+
+		if !cfg.isOriginAllowed(origin) {
+			if c.Request.Method == http.MethodOptions {
+				c.AbortWithStatus(http.StatusNoContent)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if cfg.AllowCredentials {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+		} else if cfg.hasWildcardOrigin() {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+
+		if c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != "" {
+			c.Writer.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+
+			if reqHeaders := c.GetHeader("Access-Control-Request-Headers"); reqHeaders != "" {
+				c.Writer.Header().Set("Access-Control-Allow-Headers", reqHeaders)
+			} else {
+				c.Writer.Header().Set("Access-Control-Allow-Headers", defaultAllowedHeaders)
+			}
+
+			c.Writer.Header().Set("Access-Control-Max-Age", maxAge)
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/service"
+)
+
+// ListCoursesQuery binds GET /courses?limit=&offset=&sort=&order=&q=&name=
+type ListCoursesQuery struct {
+	Limit      int    `form:"limit"`
+	Offset     int    `form:"offset"`
+	SortColumn string `form:"sort"`
+	SortOrder  string `form:"order"`
+	Q          string `form:"q"`
+	Name       string `form:"name"`
+}
+
+type CourseHandler struct {
+	courses service.CourseService
+}
+
+func NewCourseHandler(courses service.CourseService) *CourseHandler {
+	return &CourseHandler{courses: courses}
+}
+
+type CreateCourseInput struct {
+	Name string `validate:"required" json:"name"`
+	URL  string `validate:"required" json:"url"`
+	Text string `validate:"required" json:"text"`
+}
+
+type GetCourseInput struct {
+	Id string `validate:"required" json:"id"`
+}
+
+type DeleteCourseInput struct {
+	Id string `validate:"required" json:"id"`
+}
+
+type UpdateCourseInput struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	Text string `json:"text"`
+}
+
+type GetAllCoursesInput struct {
+	Ids []string `validate:"required" json:"ids"`
+}
+
+func (h *CourseHandler) Create(c *gin.Context) {
+	var req CreateCourseInput
+	if err := c.ShouldBind(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	res, err := h.courses.Create(c.Request.Context(), &model.Course{
+		Id:   uuid.New(),
+		Name: req.Name,
+		URL:  req.URL,
+		Text: req.Text,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrAlreadyExist):
+			BadRequest(c, "Already exists")
+		default:
+			Internal(c, "Unknown error")
+		}
+		return
+	}
+
+	OK(c, res)
+}
+
+func (h *CourseHandler) Update(c *gin.Context) {
+	var req UpdateCourseInput
+	if err := c.ShouldBind(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		BadRequest(c, "Invalid id")
+		return
+	}
+
+	res, err := h.courses.Update(c.Request.Context(), &model.Course{
+		Id:   id,
+		Name: req.Name,
+		URL:  req.URL,
+		Text: req.Text,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			BadRequest(c, "ErrNotFound")
+		default:
+			Internal(c, "Unknown error")
+		}
+		return
+	}
+
+	OK(c, res)
+}
+
+func (h *CourseHandler) Delete(c *gin.Context) {
+	var req DeleteCourseInput
+	if err := c.ShouldBind(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		BadRequest(c, "Invalid id")
+		return
+	}
+
+	res, err := h.courses.Delete(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			BadRequest(c, "ErrNotFound")
+		default:
+			Internal(c, "Unknown error")
+		}
+		return
+	}
+
+	OK(c, res)
+}
+
+func (h *CourseHandler) Get(c *gin.Context) {
+	var req GetCourseInput
+	if err := c.ShouldBind(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		BadRequest(c, "Invalid id")
+		return
+	}
+
+	res, err := h.courses.Get(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			BadRequest(c, "ErrNotFound")
+		default:
+			Internal(c, "Unknown error")
+		}
+		return
+	}
+
+	OK(c, res)
+}
+
+func (h *CourseHandler) GetAll(c *gin.Context) {
+	res, err := h.courses.GetAll(c.Request.Context())
+	if err != nil {
+		Internal(c, "Unknown error")
+		return
+	}
+
+	OK(c, res)
+}
+
+func (h *CourseHandler) GetByIds(c *gin.Context) {
+	var req GetAllCoursesInput
+	if err := c.ShouldBind(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.Ids))
+	for _, raw := range req.Ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			BadRequest(c, "Invalid id")
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	res, err := h.courses.GetByIds(c.Request.Context(), ids)
+	if err != nil {
+		Internal(c, "Unknown error")
+		return
+	}
+
+	OK(c, res)
+}
+
+func (h *CourseHandler) List(c *gin.Context) {
+	var q ListCoursesQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = service.DefaultListLimit
+	}
+	if q.Limit > service.MaxListLimit {
+		q.Limit = service.MaxListLimit
+	}
+
+	items, total, err := h.courses.ListCourses(c.Request.Context(), model.ListCoursesParams{
+		Limit:      q.Limit,
+		Offset:     q.Offset,
+		SortColumn: q.SortColumn,
+		SortOrder:  q.SortOrder,
+		Query:      q.Q,
+		Name:       q.Name,
+	})
+	if err != nil {
+		Internal(c, "Unknown error")
+		return
+	}
+
+	OK(c, gin.H{"items": items, "total": total, "limit": q.Limit, "offset": q.Offset})
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/service"
+)
+
+// The V1* handlers back the /api/v1/courses REST surface: real HTTP verbs,
+// ids in the URL, and RFC 7807 problem-details on error instead of the
+// legacy {"BadRequest": "..."} / {"OK": ...} envelopes.
+
+func (h *CourseHandler) ListV1(c *gin.Context) {
+	var q ListCoursesQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = service.DefaultListLimit
+	}
+	if q.Limit > service.MaxListLimit {
+		q.Limit = service.MaxListLimit
+	}
+
+	items, total, err := h.courses.ListCourses(c.Request.Context(), model.ListCoursesParams{
+		Limit:      q.Limit,
+		Offset:     q.Offset,
+		SortColumn: q.SortColumn,
+		SortOrder:  q.SortOrder,
+		Query:      q.Q,
+		Name:       q.Name,
+	})
+	if err != nil {
+		WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to list courses")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"items": items, "total": total, "limit": q.Limit, "offset": q.Offset})
+}
+
+func (h *CourseHandler) GetV1(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid id", "course id must be a UUID")
+		return
+	}
+
+	course, err := h.courses.Get(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			WriteProblem(c, http.StatusNotFound, "Not Found", "course not found")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to fetch course")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, course)
+}
+
+func (h *CourseHandler) CreateV1(c *gin.Context) {
+	var req CreateCourseInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	course, err := h.courses.Create(c.Request.Context(), &model.Course{
+		Id:   uuid.New(),
+		Name: req.Name,
+		URL:  req.URL,
+		Text: req.Text,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrAlreadyExist):
+			WriteProblem(c, http.StatusConflict, "Already Exists", "a course with this name already exists")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to create course")
+		}
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/api/v1/courses/%s", course.Id))
+	c.JSON(http.StatusCreated, course)
+}
+
+func (h *CourseHandler) UpdateV1(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid id", "course id must be a UUID")
+		return
+	}
+
+	var req UpdateCourseInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	course, err := h.courses.Update(c.Request.Context(), &model.Course{
+		Id:   id,
+		Name: req.Name,
+		URL:  req.URL,
+		Text: req.Text,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			WriteProblem(c, http.StatusNotFound, "Not Found", "course not found")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to update course")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, course)
+}
+
+func (h *CourseHandler) DeleteV1(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid id", "course id must be a UUID")
+		return
+	}
+
+	if _, err := h.courses.Delete(c.Request.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			WriteProblem(c, http.StatusNotFound, "Not Found", "course not found")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to delete course")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
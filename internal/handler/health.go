@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KozhurkinTimur/dip/internal/repository"
+)
+
+// Healthz reports that the process is alive; it never touches the DB.
+func Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz reports whether the service can currently serve traffic, i.e.
+// whether ping (typically repository.Ping) succeeds.
+func Readyz(ping repository.PingFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := ping(c.Request.Context()); err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "unavailable", "error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/service"
+)
+
+const contextUserKey = "user"
+
+// RequireAuth parses the Authorization: Bearer <token> header, verifies it's
+// a valid, non-expired access token, loads the user it refers to and stores
+// it on the gin context under contextUserKey for downstream handlers.
+func RequireAuth(users service.UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || token == "" {
+			Unauthorized(c, "Missing bearer token")
+			c.Abort()
+			return
+		}
+
+		claims, err := service.ParseToken(token)
+		if err != nil || claims.Type != "access" {
+			Unauthorized(c, "Invalid or expired token")
+			c.Abort()
+			return
+		}
+
+		user, err := users.Get(c.Request.Context(), claims.UserId)
+		if err != nil {
+			Unauthorized(c, "Invalid or expired token")
+			c.Abort()
+			return
+		}
+
+		c.Set(contextUserKey, user)
+		c.Next()
+	}
+}
+
+// RequireRole must run after RequireAuth. When admin is true it rejects
+// requests from users whose Role is false with a 403.
+func RequireRole(admin bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := c.MustGet(contextUserKey).(*model.User)
+		if !ok {
+			Unauthorized(c, "Missing bearer token")
+			c.Abort()
+			return
+		}
+
+		if admin && !user.Role {
+			Forbidden(c, "Admin role required")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
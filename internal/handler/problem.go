@@ -0,0 +1,16 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// Problem is an RFC 7807 problem-details body, used by the /api/v1 routes.
+type Problem struct {
+	Type   string `json:"type,omitempty"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func WriteProblem(c *gin.Context, status int, title, detail string) {
+	c.Header("Content-Type", "application/problem+json")
+	c.AbortWithStatusJSON(status, Problem{Title: title, Status: status, Detail: detail})
+}
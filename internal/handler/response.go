@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func BadRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, gin.H{"BadRequest": message})
+}
+
+func Internal(c *gin.Context, message string) {
+	c.JSON(http.StatusInternalServerError, gin.H{"Internal": message})
+}
+
+func Unauthorized(c *gin.Context, message string) {
+	c.JSON(http.StatusUnauthorized, gin.H{"Unauthorized": message})
+}
+
+func Forbidden(c *gin.Context, message string) {
+	c.JSON(http.StatusForbidden, gin.H{"Forbidden": message})
+}
+
+func OK(c *gin.Context, response any) {
+	c.JSON(http.StatusOK, gin.H{"OK": response})
+}
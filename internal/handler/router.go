@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/KozhurkinTimur/dip/internal/metrics"
+	"github.com/KozhurkinTimur/dip/internal/repository"
+	"github.com/KozhurkinTimur/dip/internal/service"
+)
+
+// RouterOptions controls which route generations NewRouter mounts.
+type RouterOptions struct {
+	// LegacyRoutes keeps the pre-v1 POST-everything routes registered
+	// alongside /api/v1, for clients that haven't migrated yet.
+	LegacyRoutes bool
+
+	// StaticDir, when non-empty, is served under /static - used by the fs
+	// attachment storage backend to hand uploaded files back out.
+	StaticDir string
+
+	// Ping backs /readyz; a nil Ping makes /readyz always report ready.
+	Ping repository.PingFunc
+}
+
+// NewRouter builds the gin engine and wires every route to its handler.
+func NewRouter(users service.UserService, courses service.CourseService, attachments service.AttachmentService, opts RouterOptions) *gin.Engine {
+	userHandler := NewUserHandler(users)
+	courseHandler := NewCourseHandler(courses)
+	attachmentHandler := NewAttachmentHandler(attachments)
+
+	r := gin.Default()
+	r.Use(corsMiddleware(CORSConfigFromEnv()))
+	r.Use(metrics.Middleware())
+
+	if opts.StaticDir != "" {
+		r.Static("/static", opts.StaticDir)
+	}
+
+	r.GET("/", func(c *gin.Context) {
+		c.JSON(200, gin.H{
+			"message": "Hello, World!",
+		})
+	})
+
+	r.GET("/healthz", Healthz)
+	ping := opts.Ping
+	if ping == nil {
+		ping = func(context.Context) error { return nil }
+	}
+	r.GET("/readyz", Readyz(ping))
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	registerV1Routes(r, userHandler, courseHandler, attachmentHandler, users)
+
+	if opts.LegacyRoutes {
+		registerLegacyRoutes(r, userHandler, courseHandler, users)
+	}
+
+	return r
+}
+
+func registerV1Routes(r *gin.Engine, userHandler *UserHandler, courseHandler *CourseHandler, attachmentHandler *AttachmentHandler, users service.UserService) {
+	v1 := r.Group("/api/v1")
+
+	auth := v1.Group("/auth")
+	auth.POST("/register", userHandler.RegisterV1)
+	auth.POST("/login", userHandler.LoginV1)
+
+	v1.GET("/courses", courseHandler.ListV1)
+	v1.GET("/courses/:id", courseHandler.GetV1)
+	v1.GET("/courses/:id/attachments", attachmentHandler.List)
+
+	coursesAdmin := v1.Group("/courses")
+	coursesAdmin.Use(RequireAuth(users), RequireRole(true))
+	coursesAdmin.POST("", courseHandler.CreateV1)
+	coursesAdmin.PUT("/:id", courseHandler.UpdateV1)
+	coursesAdmin.DELETE("/:id", courseHandler.DeleteV1)
+	coursesAdmin.POST("/:id/attachments", attachmentHandler.Upload)
+	coursesAdmin.DELETE("/:id/attachments/:attachmentId", attachmentHandler.Delete)
+}
+
+// registerLegacyRoutes wires the pre-v1 POST-everything surface, kept around
+// behind --legacy-routes for one release while clients migrate to /api/v1.
+func registerLegacyRoutes(r *gin.Engine, userHandler *UserHandler, courseHandler *CourseHandler, users service.UserService) {
+	r.POST("/registraition", userHandler.Register)
+	r.POST("/signIn", userHandler.SignIn)
+	r.POST("/refresh", userHandler.Refresh)
+	r.POST("/signOut", RequireAuth(users), userHandler.SignOut)
+
+	r.POST("/getCourse", courseHandler.Get)
+	r.POST("/getCourses", courseHandler.GetAll)
+	r.POST("/coursesByIds", courseHandler.GetByIds)
+	r.GET("/courses", courseHandler.List)
+
+	courseAdmin := r.Group("/")
+	courseAdmin.Use(RequireAuth(users), RequireRole(true))
+	courseAdmin.POST("/createCourse", courseHandler.Create)
+	courseAdmin.POST("/updateCourse", courseHandler.Update)
+	courseAdmin.POST("/deleteCourse", courseHandler.Delete)
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/service"
+)
+
+type UserHandler struct {
+	users service.UserService
+}
+
+func NewUserHandler(users service.UserService) *UserHandler {
+	return &UserHandler{users: users}
+}
+
+// AuthInput is the self-registration payload. There is no Role field on
+// purpose - a registering client must never be able to hand itself the
+// admin role; admins are granted out-of-band (direct DB grant or a
+// separate admin-only path), not through this endpoint.
+type AuthInput struct {
+	Email    string `validate:"required" json:"email"`
+	Password string `validate:"required" json:"password"`
+}
+
+type SignInInput struct {
+	Email    string `validate:"required" json:"email"`
+	Password string `validate:"required" json:"password"`
+}
+
+type RefreshInput struct {
+	RefreshToken string `validate:"required" json:"refresh_token"`
+}
+
+// UserResponse is what we hand back to clients; it never carries Password.
+type UserResponse struct {
+	Id    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
+	Role  bool      `json:"role"`
+}
+
+func NewUserResponse(user *model.User) *UserResponse {
+	return &UserResponse{Id: user.Id, Email: user.Email, Role: user.Role}
+}
+
+func (h *UserHandler) Register(c *gin.Context) {
+	var req AuthInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	res, err := h.users.Register(c.Request.Context(), req.Email, req.Password, false)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrAlreadyExist):
+			BadRequest(c, "Already exists")
+		default:
+			Internal(c, "Unknown error")
+		}
+		return
+	}
+
+	OK(c, NewUserResponse(res))
+}
+
+func (h *UserHandler) SignIn(c *gin.Context) {
+	var req SignInInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	_, tokens, err := h.users.SignIn(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			Unauthorized(c, "Invalid email or password")
+		default:
+			Internal(c, "Unknown error")
+		}
+		return
+	}
+
+	OK(c, gin.H{"access_token": tokens.AccessToken, "refresh_token": tokens.RefreshToken})
+}
+
+func (h *UserHandler) Refresh(c *gin.Context) {
+	var req RefreshInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		BadRequest(c, "Invalid request")
+		return
+	}
+
+	tokens, err := h.users.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		Unauthorized(c, "Invalid refresh token")
+		return
+	}
+
+	OK(c, gin.H{"access_token": tokens.AccessToken, "refresh_token": tokens.RefreshToken})
+}
+
+func (h *UserHandler) SignOut(c *gin.Context) {
+	// Tokens are stateless JWTs, so signing out is the client's
+	// responsibility (discard the pair); this just confirms the
+	// access token was valid.
+	OK(c, gin.H{"message": "signed out"})
+}
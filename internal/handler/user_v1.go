@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+)
+
+func (h *UserHandler) RegisterV1(c *gin.Context) {
+	var req AuthInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	user, err := h.users.Register(c.Request.Context(), req.Email, req.Password, false)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrAlreadyExist):
+			WriteProblem(c, http.StatusConflict, "Already Exists", "a user with this email already exists")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to register user")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, NewUserResponse(user))
+}
+
+func (h *UserHandler) LoginV1(c *gin.Context) {
+	var req SignInInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		WriteProblem(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	_, tokens, err := h.users.SignIn(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			WriteProblem(c, http.StatusUnauthorized, "Unauthorized", "invalid email or password")
+		default:
+			WriteProblem(c, http.StatusInternalServerError, "Internal Server Error", "failed to sign in")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"access_token": tokens.AccessToken, "refresh_token": tokens.RefreshToken})
+}
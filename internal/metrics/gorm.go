@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+)
+
+var gormOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "gorm_operation_duration_seconds",
+	Help:    "GORM callback latency in seconds, labeled by operation.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"operation"})
+
+const startTimeKey = "metrics:start_time"
+
+// RegisterGormCallbacks times create/query/update/delete around the named
+// gorm callback they wrap, so slow queries show up in gorm_operation_duration_seconds.
+func RegisterGormCallbacks(db *gorm.DB) error {
+	for _, op := range []string{"create", "query", "update", "delete"} {
+		callback := gormCallback(db, op)
+
+		if err := callback.Before("gorm:"+op).Register("metrics:before_"+op, startTimer); err != nil {
+			return err
+		}
+		if err := callback.After("gorm:"+op).Register("metrics:after_"+op, observeDuration(op)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func gormCallback(db *gorm.DB, op string) *gorm.Callback {
+	switch op {
+	case "create":
+		return db.Callback().Create()
+	case "update":
+		return db.Callback().Update()
+	case "delete":
+		return db.Callback().Delete()
+	default:
+		return db.Callback().Query()
+	}
+}
+
+func startTimer(db *gorm.DB) {
+	db.InstanceSet(startTimeKey, time.Now())
+}
+
+func observeDuration(op string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(startTimeKey)
+		if !ok {
+			return
+		}
+
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		gormOperationDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,15 @@
+package model
+
+import "github.com/google/uuid"
+
+// Attachment is a course material (PDF, video, image, ...) stored through a
+// pluggable Storage backend; URL points at wherever that backend serves it.
+type Attachment struct {
+	Id          uuid.UUID `gorm:"primaryKey;type:uuid;column:attachment_id"`
+	CourseId    uuid.UUID `gorm:"type:uuid;column:course_id;index"`
+	Filename    string    `gorm:"type:varchar;column:filename"`
+	ContentType string    `gorm:"type:varchar;column:content_type"`
+	Size        int64     `gorm:"column:size"`
+	StorageKey  string    `gorm:"type:varchar;column:storage_key"`
+	URL         string    `gorm:"type:varchar;column:url"`
+}
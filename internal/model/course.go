@@ -0,0 +1,24 @@
+package model
+
+import "github.com/google/uuid"
+
+type Course struct {
+	Id   uuid.UUID `gorm:"primaryKey;type:uuid;column:course_id"`
+	Name string    `gorm:"unique;type:varchar;column:name"`
+	URL  string    `gorm:"type:varchar;column:url"`
+	Text string    `gorm:"type:text;column:text"`
+}
+
+// ListCoursesParams drives CourseRepository.ListCourses. SortColumn and
+// SortOrder are whitelisted by the service layer before they ever reach a
+// query, since they're interpolated into the ORDER BY clause.
+type ListCoursesParams struct {
+	Limit      int
+	Offset     int
+	SortColumn string
+	SortOrder  string
+	// Query fuzzy-matches across name and text (ILIKE).
+	Query string
+	// Name exact-matches the course name, applied in addition to Query.
+	Name string
+}
@@ -0,0 +1,14 @@
+package model
+
+import "errors"
+
+var (
+	ErrNotFound          = errors.New("Entity not found")
+	ErrAlreadyExist      = errors.New("Entity already exists")
+	ErrUnknown           = errors.New("Unknown error")
+	ErrInvalidEntity     = errors.New("Invalid entity")
+	ErrInvalidField      = errors.New("Invalid field")
+	ErrInvalidSQLRequest = errors.New("Invalid SQL request")
+	ErrFileTooLarge      = errors.New("File too large")
+	ErrUnsupportedType   = errors.New("Unsupported content type")
+)
@@ -0,0 +1,10 @@
+package model
+
+import "github.com/google/uuid"
+
+type User struct {
+	Id       uuid.UUID `gorm:"primaryKey;type:uuid;column:user_id"`
+	Email    string    `gorm:"unique;type:varchar;column:email"`
+	Password string    `gorm:"type:varchar;column:password"`
+	Role     bool      `gorm:"type:boolean;column:role"`
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	trmgorm "github.com/avito-tech/go-transaction-manager/gorm"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+)
+
+// AttachmentRepository is the persistence boundary for model.Attachment.
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error)
+	Get(ctx context.Context, attachmentId uuid.UUID) (*model.Attachment, error)
+	ListByCourse(ctx context.Context, courseId uuid.UUID) ([]*model.Attachment, error)
+	Delete(ctx context.Context, attachmentId uuid.UUID) (*model.Attachment, error)
+}
+
+type attachmentRepository struct {
+	db     *gorm.DB
+	getter *trmgorm.CtxGetter
+}
+
+func NewAttachmentRepository(db *gorm.DB, getter *trmgorm.CtxGetter) AttachmentRepository {
+	return &attachmentRepository{db: db, getter: getter}
+}
+
+func (r *attachmentRepository) tr(ctx context.Context) *gorm.DB {
+	return r.getter.DefaultTrOrDB(ctx, r.db).WithContext(ctx)
+}
+
+func (r *attachmentRepository) Create(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error) {
+	err := r.tr(ctx).Create(attachment).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (r *attachmentRepository) Get(ctx context.Context, attachmentId uuid.UUID) (*model.Attachment, error) {
+	attachment := new(model.Attachment)
+
+	err := r.tr(ctx).First(attachment, "attachment_id = ?", attachmentId).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return attachment, nil
+}
+
+func (r *attachmentRepository) ListByCourse(ctx context.Context, courseId uuid.UUID) ([]*model.Attachment, error) {
+	attachments := make([]*model.Attachment, 0)
+
+	err := r.tr(ctx).Where("course_id = ?", courseId).Find(&attachments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentRepository) Delete(ctx context.Context, attachmentId uuid.UUID) (*model.Attachment, error) {
+	attachment := new(model.Attachment)
+
+	result := r.tr(ctx).Clauses(clause.Returning{}).Where("attachment_id = ?", attachmentId).Delete(attachment)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return attachment, nil
+}
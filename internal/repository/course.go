@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	trmgorm "github.com/avito-tech/go-transaction-manager/gorm"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+)
+
+// CourseRepository is the persistence boundary for model.Course.
+type CourseRepository interface {
+	Create(ctx context.Context, course *model.Course) (*model.Course, error)
+	Get(ctx context.Context, courseId uuid.UUID) (*model.Course, error)
+	GetAll(ctx context.Context) ([]*model.Course, error)
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*model.Course, error)
+	ListCourses(ctx context.Context, params model.ListCoursesParams) ([]*model.Course, int64, error)
+	Update(ctx context.Context, course *model.Course) (*model.Course, error)
+	Delete(ctx context.Context, courseId uuid.UUID) (*model.Course, error)
+}
+
+type courseRepository struct {
+	db     *gorm.DB
+	getter *trmgorm.CtxGetter
+}
+
+func NewCourseRepository(db *gorm.DB, getter *trmgorm.CtxGetter) CourseRepository {
+	return &courseRepository{db: db, getter: getter}
+}
+
+func (r *courseRepository) tr(ctx context.Context) *gorm.DB {
+	return r.getter.DefaultTrOrDB(ctx, r.db).WithContext(ctx)
+}
+
+func (r *courseRepository) Create(ctx context.Context, course *model.Course) (*model.Course, error) {
+	err := r.tr(ctx).Create(course).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return nil, model.ErrAlreadyExist
+		default:
+			return nil, err
+		}
+	}
+
+	return course, nil
+}
+
+func (r *courseRepository) Get(ctx context.Context, courseId uuid.UUID) (*model.Course, error) {
+	course := new(model.Course)
+
+	err := r.tr(ctx).First(course, "course_id = ?", courseId).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return course, nil
+}
+
+func (r *courseRepository) GetAll(ctx context.Context) ([]*model.Course, error) {
+	courses := make([]*model.Course, 0)
+
+	err := r.tr(ctx).Find(&courses).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return courses, nil
+}
+
+func (r *courseRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*model.Course, error) {
+	courses := make([]*model.Course, 0)
+
+	err := r.tr(ctx).Where("course_id IN ?", ids).Find(&courses).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return courses, nil
+}
+
+// ListCourses runs a paginated, optionally filtered and sorted query.
+// params.SortColumn/SortOrder must already be whitelisted by the caller -
+// they're interpolated into the ORDER BY clause as-is.
+func (r *courseRepository) ListCourses(ctx context.Context, params model.ListCoursesParams) ([]*model.Course, int64, error) {
+	courses := make([]*model.Course, 0)
+	tr := r.tr(ctx).Model(&model.Course{})
+
+	if params.Name != "" {
+		tr = tr.Where("name = ?", params.Name)
+	}
+
+	if params.Query != "" {
+		like := "%" + params.Query + "%"
+		tr = tr.Where("name ILIKE ? OR text ILIKE ?", like, like)
+	}
+
+	var total int64
+	if err := tr.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := fmt.Sprintf("%s %s", params.SortColumn, params.SortOrder)
+	err := tr.Order(order).Limit(params.Limit).Offset(params.Offset).Find(&courses).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return courses, total, nil
+}
+
+func (r *courseRepository) Update(ctx context.Context, course *model.Course) (*model.Course, error) {
+	result := r.tr(ctx).Model(course).Where("course_id = ?", course.Id).Updates(map[string]interface{}{"name": course.Name, "url": course.URL, "text": course.Text})
+
+	if result.Error != nil {
+		switch {
+		case errors.Is(result.Error, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, result.Error
+		}
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return course, nil
+}
+
+func (r *courseRepository) Delete(ctx context.Context, courseId uuid.UUID) (*model.Course, error) {
+	course := new(model.Course)
+
+	result := r.tr(ctx).Clauses(clause.Returning{}).Where("course_id = ?", courseId).Delete(course)
+	if result.Error != nil {
+		switch {
+		case errors.Is(result.Error, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, result.Error
+		}
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return course, nil
+}
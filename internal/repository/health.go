@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// PingFunc reports whether the dependency it checks is currently reachable.
+type PingFunc func(ctx context.Context) error
+
+// Ping returns a PingFunc that checks the database with a trivial query.
+func Ping(db *gorm.DB) PingFunc {
+	return func(ctx context.Context) error {
+		return db.WithContext(ctx).Exec("SELECT 1").Error
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+)
+
+// Migrate creates/updates the schema and the trigram indexes that back
+// ListCourses' `q` search, so ILIKE '%term%' lookups on name/text stay fast.
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&model.Course{}, &model.User{}, &model.Attachment{}); err != nil {
+		return err
+	}
+
+	if err := db.Exec(`CREATE EXTENSION IF NOT EXISTS pg_trgm`).Error; err != nil {
+		return err
+	}
+
+	if err := db.Exec(`CREATE INDEX IF NOT EXISTS idx_courses_name_trgm ON courses USING GIN (name gin_trgm_ops)`).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(`CREATE INDEX IF NOT EXISTS idx_courses_text_trgm ON courses USING GIN (text gin_trgm_ops)`).Error
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	trmgorm "github.com/avito-tech/go-transaction-manager/gorm"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+)
+
+// UserRepository is the persistence boundary for model.User.
+type UserRepository interface {
+	Create(ctx context.Context, user *model.User) (*model.User, error)
+	Get(ctx context.Context, userId uuid.UUID) (*model.User, error)
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	Update(ctx context.Context, user *model.User) (*model.User, error)
+	Delete(ctx context.Context, userId uuid.UUID) (*model.User, error)
+}
+
+type userRepository struct {
+	db     *gorm.DB
+	getter *trmgorm.CtxGetter
+}
+
+func NewUserRepository(db *gorm.DB, getter *trmgorm.CtxGetter) UserRepository {
+	return &userRepository{db: db, getter: getter}
+}
+
+func (r *userRepository) tr(ctx context.Context) *gorm.DB {
+	return r.getter.DefaultTrOrDB(ctx, r.db).WithContext(ctx)
+}
+
+// Create relies on gorm.Config.TranslateError (set in cmd/server) to turn the
+// driver's raw unique-violation error into gorm.ErrDuplicatedKey; without it
+// a duplicate email would fall through to the default branch below.
+func (r *userRepository) Create(ctx context.Context, user *model.User) (*model.User, error) {
+	err := r.tr(ctx).Create(user).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return nil, model.ErrAlreadyExist
+		default:
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) Get(ctx context.Context, userId uuid.UUID) (*model.User, error) {
+	user := new(model.User)
+
+	err := r.tr(ctx).First(user, "user_id = ?", userId).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	user := new(model.User)
+
+	err := r.tr(ctx).First(user, "email = ?", email).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) Update(ctx context.Context, user *model.User) (*model.User, error) {
+	result := r.tr(ctx).Model(user).Where("user_id = ?", user.Id).Updates(map[string]interface{}{"email": user.Email, "password": user.Password})
+
+	if result.Error != nil {
+		switch {
+		case errors.Is(result.Error, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, result.Error
+		}
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) Delete(ctx context.Context, userId uuid.UUID) (*model.User, error) {
+	user := new(model.User)
+
+	result := r.tr(ctx).Clauses(clause.Returning{}).Where("user_id = ?", userId).Delete(user)
+	if result.Error != nil {
+		switch {
+		case errors.Is(result.Error, gorm.ErrRecordNotFound):
+			return nil, model.ErrNotFound
+		default:
+			return nil, result.Error
+		}
+	}
+	if result.RowsAffected == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return user, nil
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Server wraps an http.Server over the configured gin engine so main can
+// drive graceful shutdown instead of calling gin.Engine.Run directly.
+type Server struct {
+	httpServer *http.Server
+}
+
+func New(engine *gin.Engine, addr string) *Server {
+	return &Server{httpServer: &http.Server{Addr: addr, Handler: engine}}
+}
+
+// Run blocks serving until the server is shut down, returning
+// http.ErrServerClosed in the normal shutdown case.
+func (s *Server) Run() error {
+	return s.httpServer.ListenAndServe()
+}
+
+// Shutdown stops accepting new connections and waits for in-flight
+// requests to finish, up to ctx's deadline.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/repository"
+	"github.com/KozhurkinTimur/dip/internal/storage"
+)
+
+const sniffLen = 512
+
+// AttachmentService validates and stores course materials, sniffing the
+// real content type off the bytes rather than trusting the upload's
+// extension or declared Content-Type.
+type AttachmentService interface {
+	Upload(ctx context.Context, courseId uuid.UUID, filename string, size int64, r io.Reader) (*model.Attachment, error)
+	List(ctx context.Context, courseId uuid.UUID) ([]*model.Attachment, error)
+	Delete(ctx context.Context, attachmentId uuid.UUID) error
+}
+
+type attachmentService struct {
+	repo    repository.AttachmentRepository
+	store   storage.Storage
+	maxSize int64
+}
+
+func NewAttachmentService(repo repository.AttachmentRepository, store storage.Storage, maxSize int64) AttachmentService {
+	return &attachmentService{repo: repo, store: store, maxSize: maxSize}
+}
+
+func (s *attachmentService) Upload(ctx context.Context, courseId uuid.UUID, filename string, size int64, r io.Reader) (*model.Attachment, error) {
+	if size > s.maxSize {
+		return nil, model.ErrFileTooLarge
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if !isAllowedAttachmentType(contentType) {
+		return nil, model.ErrUnsupportedType
+	}
+
+	key := fmt.Sprintf("%s/%s", courseId, uuid.NewString())
+	url, err := s.store.Put(ctx, key, io.MultiReader(bytes.NewReader(head), r), contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, &model.Attachment{
+		Id:          uuid.New(),
+		CourseId:    courseId,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  key,
+		URL:         url,
+	})
+}
+
+func (s *attachmentService) List(ctx context.Context, courseId uuid.UUID) ([]*model.Attachment, error) {
+	return s.repo.ListByCourse(ctx, courseId)
+}
+
+func (s *attachmentService) Delete(ctx context.Context, attachmentId uuid.UUID) error {
+	attachment, err := s.repo.Delete(ctx, attachmentId)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, attachment.StorageKey)
+}
+
+// isAllowedAttachmentType restricts uploads to PDFs, images and videos,
+// regardless of what extension the client sent the file under.
+func isAllowedAttachmentType(contentType string) bool {
+	if contentType == "application/pdf" {
+		return true
+	}
+
+	return strings.HasPrefix(contentType, "image/") || strings.HasPrefix(contentType, "video/")
+}
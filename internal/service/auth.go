@@ -0,0 +1,129 @@
+package service
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+)
+
+const (
+	accessTokenType  = "access"
+	refreshTokenType = "refresh"
+
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// TokenPair is the access/refresh pair handed back on sign-in and refresh.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// Claims are the custom JWT claims issued on sign-in and refresh. RequireAuth
+// only uses UserId and Type from them; it loads the user from the DB on each
+// request, and RequireRole checks that loaded user's Role.
+type Claims struct {
+	UserId uuid.UUID `json:"user_id"`
+	Role   bool      `json:"role"`
+	Type   string    `json:"type"`
+	jwt.RegisteredClaims
+}
+
+func jwtSecret() []byte {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		secret = "dev-secret-change-me"
+	}
+	return []byte(secret)
+}
+
+func tokenTTL(envName string, fallback time.Duration) time.Duration {
+	raw := os.Getenv(envName)
+	if raw == "" {
+		return fallback
+	}
+
+	ttl, err := time.ParseDuration(raw)
+	if err != nil {
+		return fallback
+	}
+
+	return ttl
+}
+
+func accessTokenTTL() time.Duration {
+	return tokenTTL("JWT_ACCESS_TTL", defaultAccessTokenTTL)
+}
+
+func refreshTokenTTL() time.Duration {
+	return tokenTTL("JWT_REFRESH_TTL", defaultRefreshTokenTTL)
+}
+
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+func signToken(user *model.User, tokenType string, ttl time.Duration) (string, error) {
+	claims := Claims{
+		UserId: user.Id,
+		Role:   user.Role,
+		Type:   tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Subject:   user.Id.String(),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(jwtSecret())
+}
+
+// GenerateTokenPair issues a fresh access/refresh token pair for user.
+func GenerateTokenPair(user *model.User) (*TokenPair, error) {
+	accessToken, err := signToken(user, accessTokenType, accessTokenTTL())
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := signToken(user, refreshTokenType, refreshTokenTTL())
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// ParseToken verifies the signature and expiry of tokenString and returns its claims.
+func ParseToken(tokenString string) (*Claims, error) {
+	claims := new(Claims)
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return jwtSecret(), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
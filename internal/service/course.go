@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/repository"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+)
+
+// courseSortColumns whitelists what ListCourses may put in ORDER BY, since
+// SortColumn/SortOrder come straight from query params.
+var courseSortColumns = map[string]bool{
+	"name":      true,
+	"course_id": true,
+}
+
+// CourseService holds the business logic for courses on top of CourseRepository.
+type CourseService interface {
+	Create(ctx context.Context, course *model.Course) (*model.Course, error)
+	Get(ctx context.Context, courseId uuid.UUID) (*model.Course, error)
+	GetAll(ctx context.Context) ([]*model.Course, error)
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*model.Course, error)
+	ListCourses(ctx context.Context, params model.ListCoursesParams) ([]*model.Course, int64, error)
+	Update(ctx context.Context, course *model.Course) (*model.Course, error)
+	Delete(ctx context.Context, courseId uuid.UUID) (*model.Course, error)
+}
+
+type courseService struct {
+	repo repository.CourseRepository
+}
+
+func NewCourseService(repo repository.CourseRepository) CourseService {
+	return &courseService{repo: repo}
+}
+
+func (s *courseService) Create(ctx context.Context, course *model.Course) (*model.Course, error) {
+	return s.repo.Create(ctx, course)
+}
+
+func (s *courseService) Get(ctx context.Context, courseId uuid.UUID) (*model.Course, error) {
+	return s.repo.Get(ctx, courseId)
+}
+
+func (s *courseService) GetAll(ctx context.Context) ([]*model.Course, error) {
+	return s.repo.GetAll(ctx)
+}
+
+func (s *courseService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*model.Course, error) {
+	return s.repo.GetByIds(ctx, ids)
+}
+
+// ListCourses clamps the page size and whitelists the sort column/order
+// before delegating to the repository, since those end up in a raw ORDER BY.
+func (s *courseService) ListCourses(ctx context.Context, params model.ListCoursesParams) ([]*model.Course, int64, error) {
+	if params.Limit <= 0 {
+		params.Limit = DefaultListLimit
+	}
+	if params.Limit > MaxListLimit {
+		params.Limit = MaxListLimit
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+	if !courseSortColumns[params.SortColumn] {
+		params.SortColumn = "name"
+	}
+	if params.SortOrder != "asc" && params.SortOrder != "desc" {
+		params.SortOrder = "asc"
+	}
+
+	return s.repo.ListCourses(ctx, params)
+}
+
+func (s *courseService) Update(ctx context.Context, course *model.Course) (*model.Course, error) {
+	return s.repo.Update(ctx, course)
+}
+
+func (s *courseService) Delete(ctx context.Context, courseId uuid.UUID) (*model.Course, error) {
+	return s.repo.Delete(ctx, courseId)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/KozhurkinTimur/dip/internal/model"
+	"github.com/KozhurkinTimur/dip/internal/repository"
+)
+
+// UserService holds the business logic for users: registration, sign-in and
+// token issuance on top of UserRepository.
+type UserService interface {
+	Register(ctx context.Context, email, password string, role bool) (*model.User, error)
+	SignIn(ctx context.Context, email, password string) (*model.User, *TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
+	Get(ctx context.Context, userId uuid.UUID) (*model.User, error)
+}
+
+type userService struct {
+	repo repository.UserRepository
+}
+
+func NewUserService(repo repository.UserRepository) UserService {
+	return &userService{repo: repo}
+}
+
+func (s *userService) Register(ctx context.Context, email, password string, role bool) (*model.User, error) {
+	hashed, err := HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, &model.User{
+		Id:       uuid.New(),
+		Email:    email,
+		Password: hashed,
+		Role:     role,
+	})
+}
+
+func (s *userService) SignIn(ctx context.Context, email, password string) (*model.User, *TokenPair, error) {
+	user, err := s.repo.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !CheckPassword(user.Password, password) {
+		return nil, nil, model.ErrNotFound
+	}
+
+	tokens, err := GenerateTokenPair(user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+func (s *userService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	claims, err := ParseToken(refreshToken)
+	if err != nil || claims.Type != refreshTokenType {
+		return nil, ErrInvalidToken
+	}
+
+	user, err := s.repo.Get(ctx, claims.UserId)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return GenerateTokenPair(user)
+}
+
+func (s *userService) Get(ctx context.Context, userId uuid.UUID) (*model.User, error) {
+	return s.repo.Get(ctx, userId)
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FSStorage stores attachments on the local filesystem, served back out by
+// the handler package's r.Static("/static", baseDir).
+type FSStorage struct {
+	baseDir string
+	baseURL string
+}
+
+func NewFSStorage(baseDir, baseURL string) (*FSStorage, error) {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &FSStorage{baseDir: baseDir, baseURL: strings.TrimSuffix(baseURL, "/")}, nil
+}
+
+func (s *FSStorage) Put(_ context.Context, key string, r io.Reader, _ string) (string, error) {
+	path := filepath.Join(s.baseDir, key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return "", err
+	}
+
+	return s.baseURL + "/" + key, nil
+}
+
+func (s *FSStorage) Delete(_ context.Context, key string) error {
+	err := os.Remove(filepath.Join(s.baseDir, key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage stores attachments in an S3-compatible bucket (AWS S3, MinIO, ...).
+type S3Storage struct {
+	client     *minio.Client
+	bucket     string
+	publicBase string
+}
+
+func newS3FromEnv() (*S3Storage, error) {
+	endpoint := os.Getenv("STORAGE_S3_ENDPOINT")
+	useSSL, _ := strconv.ParseBool(os.Getenv("STORAGE_S3_USE_SSL"))
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(os.Getenv("STORAGE_S3_ACCESS_KEY"), os.Getenv("STORAGE_S3_SECRET_KEY"), ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Storage{
+		client:     client,
+		bucket:     os.Getenv("STORAGE_S3_BUCKET"),
+		publicBase: getEnvDefault("STORAGE_S3_PUBLIC_BASE", "https://"+endpoint),
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, -1, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(s.publicBase, "/") + "/" + s.bucket + "/" + key, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+)
+
+// Storage is the pluggable backend course attachments are streamed into.
+// Put must accept any reader without requiring the caller to know its size.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) (url string, err error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewFromEnv builds the backend selected by STORAGE_BACKEND (fs|s3,
+// defaulting to fs). It also returns the local directory to serve under
+// /static when the fs backend is selected, or "" for s3.
+func NewFromEnv() (Storage, string, error) {
+	switch os.Getenv("STORAGE_BACKEND") {
+	case "s3":
+		s, err := newS3FromEnv()
+		return s, "", err
+	default:
+		baseDir := getEnvDefault("STORAGE_FS_DIR", "./data/attachments")
+		baseURL := getEnvDefault("STORAGE_FS_PUBLIC_BASE", "/static")
+
+		s, err := NewFSStorage(baseDir, baseURL)
+		return s, baseDir, err
+	}
+}
+
+func getEnvDefault(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}